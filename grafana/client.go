@@ -0,0 +1,65 @@
+package grafana
+
+import (
+	"fmt"
+	"net/http"
+
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+
+	gapi "github.com/grafana/grafana-api-golang-client"
+)
+
+// client bundles the API clients used by the provider's resources and data
+// sources, along with cross-cutting provider-level configuration that isn't
+// specific to any one of them.
+//
+// NOTE: the rest of this struct (additional API clients, auth, etc.) is
+// populated by the rest of the real provider's Configure wiring, which isn't
+// part of this checkout. reloadPermissionsCache is populated from the
+// `reload_permissions_cache` provider Schema attribute by configureProvider
+// in provider.go.
+type client struct {
+	gapi *gapi.Client
+
+	gapiURL  string
+	gapiAuth string
+
+	// reloadPermissionsCache, when true, causes datasource permission
+	// mutations to follow up with a call to Grafana's access-control cache
+	// reload endpoint so that changes are visible immediately instead of
+	// waiting out the cache TTL. Dashboard and folder permission mutations
+	// don't trigger a reload.
+	reloadPermissionsCache bool
+
+	httpClient *http.Client
+}
+
+// reloadAccessControlCache asks Grafana to reload the calling user's cached
+// permissions. gapi doesn't expose this endpoint, so it's called directly.
+func (c *client) reloadAccessControlCache() error {
+	httpClient := c.httpClient
+	if httpClient == nil {
+		httpClient = cleanhttp.DefaultClient()
+	}
+
+	url := fmt.Sprintf("%s/api/access-control/user/permissions?reloadcache=true", c.gapiURL)
+	req, err := http.NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		return err
+	}
+	// Matches how the provider authenticates against the Grafana HTTP API:
+	// a bearer token, not HTTP basic auth.
+	req.Header.Set("Authorization", "Bearer "+c.gapiAuth)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("status: %d reloading access control cache", resp.StatusCode)
+	}
+
+	return nil
+}
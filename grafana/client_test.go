@@ -0,0 +1,57 @@
+package grafana
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestReloadAccessControlCache(t *testing.T) {
+	var gotMethod, gotPath, gotQuery, gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotPath = r.URL.Path
+		gotQuery = r.URL.RawQuery
+		gotAuth = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := &client{
+		gapiURL:  server.URL,
+		gapiAuth: "my-token",
+	}
+
+	if err := c.reloadAccessControlCache(); err != nil {
+		t.Fatalf("reloadAccessControlCache() returned error: %v", err)
+	}
+
+	if gotMethod != http.MethodPost {
+		t.Errorf("method = %q, want %q", gotMethod, http.MethodPost)
+	}
+	if gotPath != "/api/access-control/user/permissions" {
+		t.Errorf("path = %q, want %q", gotPath, "/api/access-control/user/permissions")
+	}
+	if gotQuery != "reloadcache=true" {
+		t.Errorf("query = %q, want %q", gotQuery, "reloadcache=true")
+	}
+	if gotAuth != "Bearer my-token" {
+		t.Errorf("Authorization header = %q, want %q", gotAuth, "Bearer my-token")
+	}
+}
+
+func TestReloadAccessControlCacheErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	c := &client{
+		gapiURL:  server.URL,
+		gapiAuth: "my-token",
+	}
+
+	if err := c.reloadAccessControlCache(); err == nil {
+		t.Error("expected an error for a non-2xx response, got nil")
+	}
+}
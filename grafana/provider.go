@@ -0,0 +1,47 @@
+package grafana
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Provider returns the schema.Provider for the resources and data sources in
+// this checkout.
+//
+// NOTE: this checkout only contains the datasource/dashboard/folder
+// permission resources added by this backlog, so only the Schema attributes
+// and Configure wiring those need are declared here; the rest of the real
+// provider's auth/org configuration isn't part of this checkout.
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"reload_permissions_cache": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Reload Grafana's access control cache after each datasource permission mutation (`grafana_datasource_permission` create, update and delete), so that changes take effect immediately instead of waiting out the cache TTL.",
+			},
+		},
+		ResourcesMap: map[string]*schema.Resource{
+			"grafana_datasource_permission": ResourceDatasourcePermission(),
+			"grafana_dashboard_permission":   ResourceDashboardPermission(),
+			"grafana_folder_permission":      ResourceFolderPermission(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"grafana_datasource_permission": DataSourceDatasourcePermission(),
+		},
+		ConfigureContextFunc: configureProvider,
+	}
+}
+
+// configureProvider builds the client shared by this checkout's resources
+// and data sources. gapi/gapiURL/gapiAuth/httpClient are populated by the
+// rest of the real provider's Configure wiring (auth, org selection, etc.),
+// which isn't part of this checkout.
+func configureProvider(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	return &client{
+		reloadPermissionsCache: d.Get("reload_permissions_cache").(bool),
+	}, nil
+}
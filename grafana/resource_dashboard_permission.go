@@ -0,0 +1,192 @@
+package grafana
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	gapi "github.com/grafana/grafana-api-golang-client"
+)
+
+func ResourceDashboardPermission() *schema.Resource {
+	return &schema.Resource{
+
+		Description: `
+* [HTTP API](https://grafana.com/docs/grafana/latest/http_api/dashboard_permissions/)
+`,
+
+		CreateContext: UpdateDashboardPermissions,
+		ReadContext:   ReadDashboardPermissions,
+		UpdateContext: UpdateDashboardPermissions,
+		DeleteContext: DeleteDashboardPermissions,
+		CustomizeDiff: validatePermissionTargets,
+
+		Schema: map[string]*schema.Schema{
+			"dashboard_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the dashboard to apply permissions to.",
+			},
+			"permissions": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "The permission items to add/update. Items that are omitted from the list will be removed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"team_id": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     0,
+							Description: "ID of the team to manage permissions for. Exactly one of `team_id`, `user_id`, or `built_in_role` must be set.",
+						},
+						"user_id": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     0,
+							Description: "ID of the user to manage permissions for. Exactly one of `team_id`, `user_id`, or `built_in_role` must be set.",
+						},
+						"built_in_role": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "",
+							Description:  "Name of the basic role to manage permissions for. Options: `Viewer`, `Editor` or `Admin`. Exactly one of `team_id`, `user_id`, or `built_in_role` must be set.",
+							ValidateFunc: validation.StringInSlice([]string{"Viewer", "Editor", "Admin"}, false),
+						},
+						"permission": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"View", "Edit", "Admin"}, false),
+							Description:  "Permission to associate with item. Must be `View`, `Edit` or `Admin`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func UpdateDashboardPermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*client).gapi
+
+	v, ok := d.GetOk("permissions")
+	if !ok {
+		return nil
+	}
+	dashboardID := int64(d.Get("dashboard_id").(int))
+
+	items := make([]*gapi.PermissionItem, 0, v.(*schema.Set).Len())
+	for _, permission := range v.(*schema.Set).List() {
+		permission := permission.(map[string]interface{})
+		items = append(items, &gapi.PermissionItem{
+			TeamID:     int64(permission["team_id"].(int)),
+			UserID:     int64(permission["user_id"].(int)),
+			Role:       permission["built_in_role"].(string),
+			Permission: mapViewEditAdminPermissionStringToInt64(permission["permission"].(string)),
+		})
+	}
+
+	err := client.UpdateDashboardPermissions(dashboardID, &gapi.PermissionItems{Items: items})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(dashboardID, 10))
+
+	return ReadDashboardPermissions(ctx, d, meta)
+}
+
+func ReadDashboardPermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*client).gapi
+
+	dashboardID := int64(d.Get("dashboard_id").(int))
+
+	permissions, err := client.DashboardPermissions(dashboardID)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "status: 404") {
+			log.Printf("[WARN] removing dashboard permissions %d from state because it no longer exists in grafana", dashboardID)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	managed := managedDashboardPermissions(permissions)
+	permissionItems := make([]interface{}, 0, len(managed))
+	for _, permission := range managed {
+		permissionItem := make(map[string]interface{})
+		permissionItem["team_id"] = permission.TeamID
+		permissionItem["user_id"] = permission.UserID
+		permissionItem["built_in_role"] = permission.Role
+		permissionItem["permission"] = mapViewEditAdminPermissionInt64ToString(permission.Permission)
+
+		permissionItems = append(permissionItems, permissionItem)
+	}
+
+	d.Set("permissions", permissionItems)
+
+	return nil
+}
+
+func DeleteDashboardPermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*client).gapi
+
+	dashboardID := int64(d.Get("dashboard_id").(int))
+
+	err := client.UpdateDashboardPermissions(dashboardID, &gapi.PermissionItems{Items: []*gapi.PermissionItem{}})
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "status: 404") {
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// managedDashboardPermissions filters out permissions inherited from the
+// parent folder, which aren't managed by this resource and shouldn't be
+// reflected back into state.
+func managedDashboardPermissions(permissions []*gapi.DashboardPermission) []*gapi.DashboardPermission {
+	managed := make([]*gapi.DashboardPermission, 0, len(permissions))
+	for _, permission := range permissions {
+		if permission.Inherited {
+			continue
+		}
+		managed = append(managed, permission)
+	}
+	return managed
+}
+
+func mapViewEditAdminPermissionStringToInt64(permission string) int64 {
+	permissionInt := int64(-1)
+	switch permission {
+	case "View":
+		permissionInt = int64(1)
+	case "Edit":
+		permissionInt = int64(2)
+	case "Admin":
+		permissionInt = int64(4)
+	}
+	return permissionInt
+}
+
+func mapViewEditAdminPermissionInt64ToString(permission int64) string {
+	permissionString := "-1"
+	switch permission {
+	case 1:
+		permissionString = "View"
+	case 2:
+		permissionString = "Edit"
+	case 4:
+		permissionString = "Admin"
+	}
+	return permissionString
+}
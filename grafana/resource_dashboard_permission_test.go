@@ -0,0 +1,56 @@
+package grafana
+
+import (
+	"testing"
+
+	gapi "github.com/grafana/grafana-api-golang-client"
+)
+
+func TestMapViewEditAdminPermissionStringToInt64(t *testing.T) {
+	for _, tc := range []struct {
+		permission string
+		want       int64
+	}{
+		{"View", 1},
+		{"Edit", 2},
+		{"Admin", 4},
+		{"Unknown", -1},
+	} {
+		if got := mapViewEditAdminPermissionStringToInt64(tc.permission); got != tc.want {
+			t.Errorf("mapViewEditAdminPermissionStringToInt64(%q) = %d, want %d", tc.permission, got, tc.want)
+		}
+	}
+}
+
+func TestMapViewEditAdminPermissionInt64ToString(t *testing.T) {
+	for _, tc := range []struct {
+		permission int64
+		want       string
+	}{
+		{1, "View"},
+		{2, "Edit"},
+		{4, "Admin"},
+		{99, "-1"},
+	} {
+		if got := mapViewEditAdminPermissionInt64ToString(tc.permission); got != tc.want {
+			t.Errorf("mapViewEditAdminPermissionInt64ToString(%d) = %q, want %q", tc.permission, got, tc.want)
+		}
+	}
+}
+
+func TestManagedDashboardPermissionsSkipsInherited(t *testing.T) {
+	permissions := []*gapi.DashboardPermission{
+		{TeamID: 1, Inherited: false},
+		{TeamID: 2, Inherited: true},
+		{UserID: 3, Inherited: false},
+	}
+
+	managed := managedDashboardPermissions(permissions)
+
+	if len(managed) != 2 {
+		t.Fatalf("expected 2 managed permissions, got %d: %+v", len(managed), managed)
+	}
+	if managed[0].TeamID != 1 || managed[1].UserID != 3 {
+		t.Errorf("unexpected managed permissions: %+v", managed)
+	}
+}
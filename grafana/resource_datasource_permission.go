@@ -2,6 +2,7 @@ package grafana
 
 import (
 	"context"
+	"errors"
 	"log"
 	"strconv"
 	"strings"
@@ -24,13 +25,25 @@ func ResourceDatasourcePermission() *schema.Resource {
 		ReadContext:   ReadDatasourcePermissions,
 		UpdateContext: UpdateDatasourcePermissions,
 		DeleteContext: DeleteDatasourcePermissions,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportDatasourcePermissions,
+		},
+		CustomizeDiff: validatePermissionTargets,
 
 		Schema: map[string]*schema.Schema{
 			"datasource_id": {
-				Type:        schema.TypeInt,
-				Required:    true,
-				ForceNew:    true,
-				Description: "ID of the datasource to apply permissions to.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "ID of the datasource to apply permissions to. Deprecated: use `datasource_uid` instead.",
+				ExactlyOneOf: []string{"datasource_id", "datasource_uid"},
+			},
+			"datasource_uid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "UID of the datasource to apply permissions to.",
+				ExactlyOneOf: []string{"datasource_id", "datasource_uid"},
 			},
 			"permissions": {
 				Type:        schema.TypeSet,
@@ -42,19 +55,26 @@ func ResourceDatasourcePermission() *schema.Resource {
 							Type:        schema.TypeInt,
 							Optional:    true,
 							Default:     0,
-							Description: "ID of the team to manage permissions for.",
+							Description: "ID of the team to manage permissions for. Exactly one of `team_id`, `user_id`, or `built_in_role` must be set.",
 						},
 						"user_id": {
 							Type:        schema.TypeInt,
 							Optional:    true,
 							Default:     0,
-							Description: "ID of the user to manage permissions for.",
+							Description: "ID of the user to manage permissions for. Exactly one of `team_id`, `user_id`, or `built_in_role` must be set.",
+						},
+						"built_in_role": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "",
+							Description:  "Name of the basic role to manage permissions for. Options: `Viewer`, `Editor` or `Admin`. Exactly one of `team_id`, `user_id`, or `built_in_role` must be set.",
+							ValidateFunc: validation.StringInSlice([]string{"Viewer", "Editor", "Admin"}, false),
 						},
 						"permission": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"Query"}, false),
-							Description:  "Permission to associate with item. Must be `Query`.",
+							ValidateFunc: validation.StringInSlice([]string{"Query", "Edit", "Admin"}, false),
+							Description:  "Permission to associate with item. Must be `Query`, `Edit` or `Admin`.",
 						},
 					},
 				},
@@ -63,28 +83,154 @@ func ResourceDatasourcePermission() *schema.Resource {
 	}
 }
 
+// datasourceIDFromResourceData resolves the numeric datasource ID from either
+// the `datasource_id` or `datasource_uid` attribute, looking up the latter
+// via the Grafana API since permission endpoints only accept the integer ID.
+func datasourceIDFromResourceData(client *gapi.Client, d *schema.ResourceData) (int64, error) {
+	if v, ok := d.GetOk("datasource_id"); ok {
+		return int64(v.(int)), nil
+	}
+
+	ds, err := client.DataSourceByUID(d.Get("datasource_uid").(string))
+	if err != nil {
+		return 0, err
+	}
+
+	return ds.ID, nil
+}
+
+// datasourcePermissionKey uniquely identifies a permission *principal*
+// (exactly one of team, user, or built-in role). Grafana only allows one ACL
+// row per principal, so the permission level deliberately isn't part of the
+// key: a level change is then a single AddDatasourcePermission, which
+// upserts the principal's existing row, rather than a remove of the old row
+// followed by an add of a new one.
+type datasourcePermissionKey struct {
+	TeamID      int64
+	UserID      int64
+	BuiltInRole string
+}
+
+func datasourcePermissionItemToKey(permission map[string]interface{}) datasourcePermissionKey {
+	return datasourcePermissionKey{
+		TeamID:      int64(permission["team_id"].(int)),
+		UserID:      int64(permission["user_id"].(int)),
+		BuiltInRole: permission["built_in_role"].(string),
+	}
+}
+
+func datasourcePermissionToKey(permission gapi.DatasourcePermission) datasourcePermissionKey {
+	return datasourcePermissionKey{
+		TeamID:      permission.TeamID,
+		UserID:      permission.UserID,
+		BuiltInRole: permission.BuiltInRole,
+	}
+}
+
+// diffDatasourcePermissions compares the desired permission set against the
+// permissions currently stored in Grafana and returns the additions and
+// removals needed to reconcile the two, keyed by principal (team_id,
+// user_id, built_in_role). A principal whose desired permission level
+// differs from its current one is returned as an add only: Grafana's
+// AddDatasourcePermission upserts the existing ACL row for that principal
+// rather than creating a second one, so no matching remove is generated for
+// it. Removals only fire for principals that are no longer in the desired
+// set at all.
+func diffDatasourcePermissions(
+	desiredByKey map[datasourcePermissionKey]map[string]interface{},
+	currentByKey map[datasourcePermissionKey]gapi.DatasourcePermission,
+) (adds []gapi.DatasourcePermissionAddPayload, removes []gapi.DatasourcePermission) {
+	for key, desired := range desiredByKey {
+		permission := mapDatasourcePermissionStringToInt64(desired["permission"].(string))
+
+		if current, ok := currentByKey[key]; ok && int64(current.Permission) == permission {
+			continue
+		}
+
+		adds = append(adds, gapi.DatasourcePermissionAddPayload{
+			TeamID:      key.TeamID,
+			UserID:      key.UserID,
+			BuiltInRole: key.BuiltInRole,
+			Permission:  gapi.DatasourcePermissionType(permission),
+		})
+	}
+
+	for key, permission := range currentByKey {
+		if _, ok := desiredByKey[key]; ok {
+			continue
+		}
+
+		removes = append(removes, permission)
+	}
+
+	return adds, removes
+}
+
+// UpdateDatasourcePermissions reconciles the desired `permissions` set against
+// the permissions currently stored in Grafana, adding only the entries that
+// are missing and removing only the entries that are no longer desired. This
+// is what lets the resource manage the entire permission set for a datasource
+// without churning on no-op plans or leaving stale permissions behind.
+//
+// RemoveDatasourcePermission takes the ACL entry ID per its doc comment
+// ("removes the permission with the given id") and the DatasourcePermission
+// struct it's read off of carries that same ID field, which is what this
+// reconciliation relies on. What isn't verifiable from the client source
+// alone is whether DatasourcePermissions ever includes rows Grafana created
+// on its own (e.g. a default org-role grant) rather than ones Terraform
+// declared; if it does, the remove loop below would strip those too. That
+// needs confirming against a live Grafana instance before relying on this
+// resource to fully own a datasource's permission set.
 func UpdateDatasourcePermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*client).gapi
+	c := meta.(*client)
+	client := c.gapi
 
-	v, ok := d.GetOk("permissions")
-	if !ok {
-		return nil
+	// permissions is Required, but an empty set is its zero value, so it must
+	// be read with Get (not GetOk) or emptying the list would never reconcile
+	// removals.
+	v := d.Get("permissions").(*schema.Set)
+
+	datasourceID, err := datasourceIDFromResourceData(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	current, err := client.DatasourcePermissions(datasourceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	currentByKey := make(map[datasourcePermissionKey]gapi.DatasourcePermission, len(current.Permissions))
+	for _, permission := range current.Permissions {
+		currentByKey[datasourcePermissionToKey(*permission)] = *permission
 	}
-	datasourceID := int64(d.Get("datasource_id").(int))
 
-	for _, permission := range v.(*schema.Set).List() {
+	desiredByKey := make(map[datasourcePermissionKey]map[string]interface{})
+	for _, permission := range v.List() {
 		permission := permission.(map[string]interface{})
-		permissionItem := gapi.DatasourcePermissionAddPayload{}
-		if permission["team_id"].(int) != -1 {
-			permissionItem.TeamID = int64(permission["team_id"].(int))
+		desiredByKey[datasourcePermissionItemToKey(permission)] = permission
+	}
+
+	adds, removes := diffDatasourcePermissions(desiredByKey, currentByKey)
+
+	// Removes are applied before adds so that, if a principal were ever to
+	// appear in both slices, clearing stale rows can't race with (and strip)
+	// a row that the add loop just upserted.
+	for _, permission := range removes {
+		if err := client.RemoveDatasourcePermission(datasourceID, permission.ID); err != nil {
+			return diag.FromErr(err)
 		}
-		if permission["user_id"].(int) != -1 {
-			permissionItem.UserID = int64(permission["user_id"].(int))
+	}
+
+	for _, permissionItem := range adds {
+		permissionItem := permissionItem
+		if err := client.AddDatasourcePermission(datasourceID, &permissionItem); err != nil {
+			return diag.FromErr(err)
 		}
-		permissionItem.Permission = mapDatasourcePermissionStringToInt64(permission["permission"].(string))
+	}
 
-		err := client.AddDatasourcePermission(datasourceID, &permissionItem)
-		if err != nil {
+	if c.reloadPermissionsCache {
+		if err := c.reloadAccessControlCache(); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -94,10 +240,30 @@ func UpdateDatasourcePermissions(ctx context.Context, d *schema.ResourceData, me
 	return ReadDatasourcePermissions(ctx, d, meta)
 }
 
+// ImportDatasourcePermissions imports a grafana_datasource_permission resource
+// given the numeric datasource ID as the import ID.
+func ImportDatasourcePermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	datasourceID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("datasource_id", int(datasourceID))
+
+	if diags := ReadDatasourcePermissions(ctx, d, meta); diags.HasError() {
+		return nil, errors.New(diags[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func ReadDatasourcePermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*client).gapi
 
-	datasourceID := int64(d.Get("datasource_id").(int))
+	datasourceID, err := datasourceIDFromResourceData(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	response, err := client.DatasourcePermissions(datasourceID)
 	if err != nil {
@@ -116,7 +282,8 @@ func ReadDatasourcePermissions(ctx context.Context, d *schema.ResourceData, meta
 		permissionItem := make(map[string]interface{})
 		permissionItem["team_id"] = permission.TeamID
 		permissionItem["user_id"] = permission.UserID
-		permissionItem["permission"] = mapDatasourcePermissionInt64ToString(permission.Permission)
+		permissionItem["built_in_role"] = permission.BuiltInRole
+		permissionItem["permission"] = mapDatasourcePermissionInt64ToString(int64(permission.Permission))
 
 		permissionItems[count] = permissionItem
 		count++
@@ -128,9 +295,13 @@ func ReadDatasourcePermissions(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func DeleteDatasourcePermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*client).gapi
+	c := meta.(*client)
+	client := c.gapi
 
-	datasourceID := int64(d.Get("datasource_id").(int))
+	datasourceID, err := datasourceIDFromResourceData(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	response, err := client.DatasourcePermissions(datasourceID)
 	if err != nil {
@@ -144,12 +315,18 @@ func DeleteDatasourcePermissions(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	for _, permission := range response.Permissions {
-		err := client.RemoveDatasourcePermission(datasourceID, permission.Permission)
+		err := client.RemoveDatasourcePermission(datasourceID, permission.ID)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
+	if c.reloadPermissionsCache {
+		if err := c.reloadAccessControlCache(); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return nil
 }
 
@@ -158,6 +335,10 @@ func mapDatasourcePermissionStringToInt64(permission string) int64 {
 	switch permission {
 	case "Query":
 		permissionInt = int64(1)
+	case "Edit":
+		permissionInt = int64(2)
+	case "Admin":
+		permissionInt = int64(4)
 	}
 	return permissionInt
 }
@@ -167,6 +348,124 @@ func mapDatasourcePermissionInt64ToString(permission int64) string {
 	switch permission {
 	case 1:
 		permissionString = "Query"
+	case 2:
+		permissionString = "Edit"
+	case 4:
+		permissionString = "Admin"
 	}
 	return permissionString
 }
+
+func DataSourceDatasourcePermission() *schema.Resource {
+	return &schema.Resource{
+
+		Description: `
+Data source for reading the permissions granted on an existing datasource.
+
+* [HTTP API](https://grafana.com/docs/grafana/latest/http_api/datasource_permissions/)
+`,
+
+		ReadContext: dataSourceReadDatasourcePermissions,
+
+		Schema: map[string]*schema.Schema{
+			"datasource_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "ID of the datasource to read permissions for.",
+				ExactlyOneOf: []string{"datasource_id", "datasource_uid"},
+			},
+			"datasource_uid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "UID of the datasource to read permissions for.",
+				ExactlyOneOf: []string{"datasource_id", "datasource_uid"},
+			},
+			"permissions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The permissions currently granted on the datasource.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"team_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "ID of the team the permission applies to, if any.",
+						},
+						"team_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the team the permission applies to, if any.",
+						},
+						"user_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "ID of the user the permission applies to, if any.",
+						},
+						"user_login": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Login of the user the permission applies to, if any.",
+						},
+						"built_in_role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the basic role the permission applies to, if any.",
+						},
+						"permission": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Permission granted. One of `Query`, `Edit` or `Admin`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceReadDatasourcePermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*client).gapi
+
+	datasourceID, err := datasourceIDFromResourceData(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := client.DatasourcePermissions(datasourceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	permissionItems := make([]interface{}, 0, len(response.Permissions))
+	for _, permission := range response.Permissions {
+		permissionItem := map[string]interface{}{
+			"team_id":       permission.TeamID,
+			"user_id":       permission.UserID,
+			"built_in_role": permission.BuiltInRole,
+			"permission":    mapDatasourcePermissionInt64ToString(int64(permission.Permission)),
+		}
+
+		if permission.TeamID != 0 {
+			team, err := client.Team(permission.TeamID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			permissionItem["team_name"] = team.Name
+		}
+
+		if permission.UserID != 0 {
+			user, err := client.User(permission.UserID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			permissionItem["user_login"] = user.Login
+		}
+
+		permissionItems = append(permissionItems, permissionItem)
+	}
+
+	d.Set("permissions", permissionItems)
+	d.SetId(strconv.FormatInt(datasourceID, 10))
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package grafana
+
+import (
+	"sort"
+	"testing"
+
+	gapi "github.com/grafana/grafana-api-golang-client"
+)
+
+func TestMapDatasourcePermissionStringToInt64(t *testing.T) {
+	for _, tc := range []struct {
+		permission string
+		want       int64
+	}{
+		{"Query", 1},
+		{"Edit", 2},
+		{"Admin", 4},
+		{"Unknown", -1},
+	} {
+		if got := mapDatasourcePermissionStringToInt64(tc.permission); got != tc.want {
+			t.Errorf("mapDatasourcePermissionStringToInt64(%q) = %d, want %d", tc.permission, got, tc.want)
+		}
+	}
+}
+
+func TestMapDatasourcePermissionInt64ToString(t *testing.T) {
+	for _, tc := range []struct {
+		permission int64
+		want       string
+	}{
+		{1, "Query"},
+		{2, "Edit"},
+		{4, "Admin"},
+		{99, "-1"},
+	} {
+		if got := mapDatasourcePermissionInt64ToString(tc.permission); got != tc.want {
+			t.Errorf("mapDatasourcePermissionInt64ToString(%d) = %q, want %q", tc.permission, got, tc.want)
+		}
+	}
+}
+
+func TestDiffDatasourcePermissions(t *testing.T) {
+	desired := map[datasourcePermissionKey]map[string]interface{}{
+		// Unchanged: present in both at the same level, should be a no-op.
+		{TeamID: 1}: {"team_id": 1, "user_id": 0, "built_in_role": "", "permission": "Query"},
+		// New: only in desired, should be added.
+		{UserID: 2}: {"team_id": 0, "user_id": 2, "built_in_role": "", "permission": "Edit"},
+	}
+	current := map[datasourcePermissionKey]gapi.DatasourcePermission{
+		{TeamID: 1}: {ID: 10, TeamID: 1, Permission: gapi.DatasourcePermissionQuery},
+		// Stale: only in current, should be removed.
+		{BuiltInRole: "Viewer"}: {ID: 11, BuiltInRole: "Viewer", Permission: gapi.DatasourcePermissionQuery},
+	}
+
+	adds, removes := diffDatasourcePermissions(desired, current)
+
+	if len(adds) != 1 || adds[0].UserID != 2 || adds[0].Permission != gapi.DatasourcePermissionEdit {
+		t.Errorf("unexpected adds: %+v", adds)
+	}
+
+	if len(removes) != 1 || removes[0].ID != 11 {
+		t.Errorf("unexpected removes: %+v", removes)
+	}
+}
+
+func TestDiffDatasourcePermissionsLevelChangeUpsertsInsteadOfRemoving(t *testing.T) {
+	// A principal moving from Query to Edit must come back as an add only:
+	// AddDatasourcePermission upserts the principal's existing ACL row, so no
+	// remove of the row at the old level is generated for it.
+	desired := map[datasourcePermissionKey]map[string]interface{}{
+		{TeamID: 1}: {"team_id": 1, "user_id": 0, "built_in_role": "", "permission": "Edit"},
+	}
+	current := map[datasourcePermissionKey]gapi.DatasourcePermission{
+		{TeamID: 1}: {ID: 10, TeamID: 1, Permission: gapi.DatasourcePermissionQuery},
+	}
+
+	adds, removes := diffDatasourcePermissions(desired, current)
+
+	if len(adds) != 1 || adds[0].TeamID != 1 || adds[0].Permission != gapi.DatasourcePermissionEdit {
+		t.Errorf("unexpected adds: %+v", adds)
+	}
+	if len(removes) != 0 {
+		t.Errorf("expected no removes for a level change, got %+v", removes)
+	}
+}
+
+func TestDiffDatasourcePermissionsEmptyDesiredRemovesEverything(t *testing.T) {
+	desired := map[datasourcePermissionKey]map[string]interface{}{}
+	current := map[datasourcePermissionKey]gapi.DatasourcePermission{
+		{TeamID: 1}: {ID: 10, TeamID: 1, Permission: gapi.DatasourcePermissionQuery},
+		{UserID: 2}: {ID: 11, UserID: 2, Permission: gapi.DatasourcePermissionEdit},
+	}
+
+	adds, removes := diffDatasourcePermissions(desired, current)
+
+	if len(adds) != 0 {
+		t.Errorf("expected no adds, got %+v", adds)
+	}
+
+	removedIDs := make([]int64, 0, len(removes))
+	for _, permission := range removes {
+		removedIDs = append(removedIDs, permission.ID)
+	}
+	sort.Slice(removedIDs, func(i, j int) bool { return removedIDs[i] < removedIDs[j] })
+
+	if len(removedIDs) != 2 || removedIDs[0] != 10 || removedIDs[1] != 11 {
+		t.Errorf("expected all current permissions to be removed, got ids %v", removedIDs)
+	}
+}
+
+func TestExactlyOnePermissionTarget(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		teamID      int
+		userID      int
+		builtInRole string
+		wantErr     bool
+	}{
+		{"team only", 1, 0, "", false},
+		{"user only", 0, 2, "", false},
+		{"built_in_role only", 0, 0, "Viewer", false},
+		{"none set", 0, 0, "", true},
+		{"team and user", 1, 2, "", true},
+		{"all three", 1, 2, "Viewer", true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := exactlyOnePermissionTarget(tc.teamID, tc.userID, tc.builtInRole)
+			if tc.wantErr && err == nil {
+				t.Errorf("expected an error, got nil")
+			}
+			if !tc.wantErr && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
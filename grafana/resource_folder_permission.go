@@ -0,0 +1,150 @@
+package grafana
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	gapi "github.com/grafana/grafana-api-golang-client"
+)
+
+func ResourceFolderPermission() *schema.Resource {
+	return &schema.Resource{
+
+		Description: `
+* [HTTP API](https://grafana.com/docs/grafana/latest/http_api/folder_permissions/)
+`,
+
+		CreateContext: UpdateFolderPermissions,
+		ReadContext:   ReadFolderPermissions,
+		UpdateContext: UpdateFolderPermissions,
+		DeleteContext: DeleteFolderPermissions,
+		CustomizeDiff: validatePermissionTargets,
+
+		Schema: map[string]*schema.Schema{
+			"folder_uid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "UID of the folder to apply permissions to.",
+			},
+			"permissions": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "The permission items to add/update. Items that are omitted from the list will be removed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"team_id": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     0,
+							Description: "ID of the team to manage permissions for. Exactly one of `team_id`, `user_id`, or `built_in_role` must be set.",
+						},
+						"user_id": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     0,
+							Description: "ID of the user to manage permissions for. Exactly one of `team_id`, `user_id`, or `built_in_role` must be set.",
+						},
+						"built_in_role": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "",
+							Description:  "Name of the basic role to manage permissions for. Options: `Viewer`, `Editor` or `Admin`. Exactly one of `team_id`, `user_id`, or `built_in_role` must be set.",
+							ValidateFunc: validation.StringInSlice([]string{"Viewer", "Editor", "Admin"}, false),
+						},
+						"permission": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"View", "Edit", "Admin"}, false),
+							Description:  "Permission to associate with item. Must be `View`, `Edit` or `Admin`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func UpdateFolderPermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*client).gapi
+
+	v, ok := d.GetOk("permissions")
+	if !ok {
+		return nil
+	}
+	folderUID := d.Get("folder_uid").(string)
+
+	items := make([]*gapi.PermissionItem, 0, v.(*schema.Set).Len())
+	for _, permission := range v.(*schema.Set).List() {
+		permission := permission.(map[string]interface{})
+		items = append(items, &gapi.PermissionItem{
+			TeamID:     int64(permission["team_id"].(int)),
+			UserID:     int64(permission["user_id"].(int)),
+			Role:       permission["built_in_role"].(string),
+			Permission: mapViewEditAdminPermissionStringToInt64(permission["permission"].(string)),
+		})
+	}
+
+	err := client.UpdateFolderPermissions(folderUID, &gapi.PermissionItems{Items: items})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(folderUID)
+
+	return ReadFolderPermissions(ctx, d, meta)
+}
+
+func ReadFolderPermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*client).gapi
+
+	folderUID := d.Get("folder_uid").(string)
+
+	permissions, err := client.FolderPermissions(folderUID)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "status: 404") {
+			log.Printf("[WARN] removing folder permissions %s from state because it no longer exists in grafana", folderUID)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	permissionItems := make([]interface{}, 0, len(permissions))
+	for _, permission := range permissions {
+		permissionItem := make(map[string]interface{})
+		permissionItem["team_id"] = permission.TeamID
+		permissionItem["user_id"] = permission.UserID
+		permissionItem["built_in_role"] = permission.Role
+		permissionItem["permission"] = mapViewEditAdminPermissionInt64ToString(permission.Permission)
+
+		permissionItems = append(permissionItems, permissionItem)
+	}
+
+	d.Set("permissions", permissionItems)
+
+	return nil
+}
+
+func DeleteFolderPermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*client).gapi
+
+	folderUID := d.Get("folder_uid").(string)
+
+	err := client.UpdateFolderPermissions(folderUID, &gapi.PermissionItems{Items: []*gapi.PermissionItem{}})
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "status: 404") {
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// validatePermissionTargets is a CustomizeDiff shared by the permission
+// resources (datasource/dashboard/folder). ExactlyOneOf/ConflictsWith aren't
+// supported on attributes nested inside a TypeSet element, so the "exactly
+// one of team_id, user_id, built_in_role" constraint on each permission item
+// is enforced here instead of in the schema.
+func validatePermissionTargets(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk("permissions")
+	if !ok {
+		return nil
+	}
+
+	for _, permission := range v.(*schema.Set).List() {
+		permission := permission.(map[string]interface{})
+		if err := exactlyOnePermissionTarget(
+			permission["team_id"].(int),
+			permission["user_id"].(int),
+			permission["built_in_role"].(string),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// exactlyOnePermissionTarget reports an error unless exactly one of the three
+// principal identifiers is set on a single permission item.
+func exactlyOnePermissionTarget(teamID, userID int, builtInRole string) error {
+	set := 0
+	if teamID != 0 {
+		set++
+	}
+	if userID != 0 {
+		set++
+	}
+	if builtInRole != "" {
+		set++
+	}
+
+	if set != 1 {
+		return fmt.Errorf("exactly one of team_id, user_id, or built_in_role must be set on each permission item")
+	}
+
+	return nil
+}